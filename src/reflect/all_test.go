@@ -5733,6 +5733,102 @@ func TestFuncOf(t *testing.T) {
 	shouldPanic("must be slice", func() { FuncOf(nil, nil, true) })
 }
 
+type interfaceOfT int
+
+type interfaceOfF func() int
+
+func (interfaceOfT) Get() int             { return 42 }
+func (interfaceOfT) String() string       { return "interfaceOfT" }
+func (interfaceOfT) Sum(x ...int) int     { return len(x) }
+func (*interfaceOfT) Set(int) (int, bool) { return 0, false }
+
+func TestInterfaceOf(t *testing.T) {
+	// check construction and use of type not in binary
+	get := Method{Name: "Get", Type: TypeOf((func() int)(nil))}
+	str := Method{Name: "String", Type: TypeOf((func() string)(nil))}
+	sum := Method{Name: "Sum", Type: TypeOf((func(...int) int)(nil))}
+	it := InterfaceOf([]Method{sum, str, get})
+
+	const itStr = `interface { Get() int; String() string; Sum(...int) int }`
+	if got, want := it.String(), itStr; got != want {
+		t.Errorf("InterfaceOf(methods).String()=%q, want %q", got, want)
+	}
+	if it.Kind() != Interface || it.NumMethod() != 3 {
+		t.Fatalf("InterfaceOf(methods) = %v with %d methods, want interface with 3 methods", it.Kind(), it.NumMethod())
+	}
+	for i, want := range []string{"Get", "String", "Sum"} {
+		if m := it.Method(i); m.Name != want {
+			t.Errorf("InterfaceOf(methods).Method(%d).Name = %q, want %q", i, m.Name, want)
+		}
+	}
+	if it2 := InterfaceOf([]Method{get, sum, str}); it2 != it {
+		t.Errorf("InterfaceOf(methods) not canonical: have %p, want %p", it2, it)
+	}
+
+	tt := TypeOf(interfaceOfT(0))
+	if !tt.Implements(it) || !tt.AssignableTo(it) {
+		t.Errorf("%v does not implement %v", tt, it)
+	}
+	if !it.Implements(TypeOf((*fmt.Stringer)(nil)).Elem()) {
+		t.Errorf("%v does not implement fmt.Stringer", it)
+	}
+	if TypeOf(0).Implements(it) {
+		t.Errorf("int implements %v", it)
+	}
+
+	v := New(it).Elem()
+	runtime.GC()
+	v.Set(ValueOf(interfaceOfT(0)))
+	runtime.GC()
+	if got := v.Interface().(fmt.Stringer).String(); got != "interfaceOfT" {
+		t.Errorf("String() = %q, want %q", got, "interfaceOfT")
+	}
+	if got := v.Method(0).Call(nil)[0].Int(); got != 42 {
+		t.Errorf("Get() = %d, want 42", got)
+	}
+	if got := ValueOf(interfaceOfT(0)).Convert(it).Elem().Interface(); got != interfaceOfT(0) {
+		t.Errorf("Convert(%v) = %v, want %v", it, got, interfaceOfT(0))
+	}
+
+	// pointer receiver methods are only in the method set of the pointer
+	set := Method{Name: "Set", Type: TypeOf((func(int) (int, bool))(nil))}
+	pt := InterfaceOf([]Method{set, get})
+	if tt.Implements(pt) || !PtrTo(tt).Implements(pt) {
+		t.Errorf("Implements(%v) wrong for %v and %v", pt, tt, PtrTo(tt))
+	}
+
+	if InterfaceOf(nil) != TypeOf((*interface{})(nil)).Elem() {
+		t.Errorf("InterfaceOf(nil) != interface{}")
+	}
+
+	// check that types already in binary are found
+	testCases := []struct {
+		methods []Method
+		want    Type
+	}{
+		{[]Method{str}, TypeOf((*interface{ String() string })(nil)).Elem()},
+		{[]Method{str, get}, TypeOf((*interface {
+			Get() int
+			String() string
+		})(nil)).Elem()},
+	}
+	for _, tt := range testCases {
+		if got := InterfaceOf(tt.methods); got != tt.want {
+			t.Errorf("InterfaceOf(%v) = %p (%v), want %p (%v)", tt.methods, got, got, tt.want, tt.want)
+		}
+	}
+
+	shouldPanic("has no name", func() { InterfaceOf([]Method{{Type: get.Type}}) })
+	shouldPanic("has invalid name", func() { InterfaceOf([]Method{{Name: "1nvalid", Type: get.Type}}) })
+	shouldPanic("is unexported", func() { InterfaceOf([]Method{{Name: "get", Type: get.Type}}) })
+	shouldPanic("is unexported", func() { InterfaceOf([]Method{{Name: "ñame", Type: get.Type}}) })
+	shouldPanic("is unexported", func() { InterfaceOf([]Method{{Name: "_Get", Type: get.Type}}) })
+	shouldPanic("has no type", func() { InterfaceOf([]Method{{Name: "Get"}}) })
+	shouldPanic("has non-func type", func() { InterfaceOf([]Method{{Name: "Get", Type: TypeOf(0)}}) })
+	shouldPanic("has named type", func() { InterfaceOf([]Method{{Name: "Get", Type: TypeOf(interfaceOfF(nil))}}) })
+	shouldPanic("duplicate method", func() { InterfaceOf([]Method{get, str, get}) })
+}
+
 type B1 struct {
 	X int
 	Y int
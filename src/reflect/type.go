@@ -1769,6 +1769,17 @@ var funcLookupCache struct {
 	m sync.Map
 }
 
+// The interfaceLookupCache caches InterfaceOf lookups.
+// InterfaceOf does not share the common lookupCache since cacheKey is not
+// sufficient to represent interfaces unambiguously.
+var interfaceLookupCache struct {
+	sync.Mutex // Guards stores (but not loads) on m.
+
+	// m is a map[uint32][]*rtype keyed by the hash calculated in InterfaceOf.
+	// Elements of m are append-only and thus safe for concurrent reading.
+	m sync.Map
+}
+
 // ChanOf returns the channel type with the given direction and element type.
 // For example, if t represents int, ChanOf(RecvDir, t) represents <-chan int.
 //
@@ -2084,6 +2095,148 @@ func funcStr(ft *funcType) string {
 	return string(repr)
 }
 
+// InterfaceOf returns the interface type containing methods.
+// Only the Name and Type fields of each Method are used: Type must be
+// an unnamed func type describing the method signature without a receiver,
+// as returned by Method for interface types.
+// The order of methods is irrelevant: they are sorted by name,
+// as the compiler does.
+// If the program already contains an identical interface type,
+// InterfaceOf returns that type, so the result compares equal to it.
+//
+// InterfaceOf currently does not support embedded interfaces and
+// panics if passed unexported or duplicate methods.
+// These limitations may be lifted in a future version.
+func InterfaceOf(methods []Method) Type {
+	if len(methods) == 0 {
+		return TypeOf((*interface{})(nil)).Elem()
+	}
+
+	ms := make([]Method, len(methods))
+	for i, m := range methods {
+		if m.Name == "" {
+			panic("reflect.InterfaceOf: method " + strconv.Itoa(i) + " has no name")
+		}
+		if !isValidFieldName(m.Name) {
+			panic("reflect.InterfaceOf: method " + strconv.Itoa(i) + " has invalid name")
+		}
+		// The name is encoded as exported below, so unlike
+		// runtimeStructField this check must be exact.
+		if r, _ := utf8.DecodeRuneInString(m.Name); m.PkgPath != "" || !unicode.IsUpper(r) {
+			panic("reflect.InterfaceOf: method \"" + m.Name + "\" is unexported")
+		}
+		if m.Type == nil {
+			panic("reflect.InterfaceOf: method \"" + m.Name + "\" has no type")
+		}
+		if m.Type.Kind() != Func {
+			panic("reflect.InterfaceOf: method \"" + m.Name + "\" has non-func type " + m.Type.String())
+		}
+		if m.Type.Name() != "" {
+			panic("reflect.InterfaceOf: method \"" + m.Name + "\" has named type " + m.Type.String())
+		}
+		// Insertion sort by name, the list is usually short.
+		j := i
+		for ; j > 0 && ms[j-1].Name >= m.Name; j-- {
+			if ms[j-1].Name == m.Name {
+				panic("reflect.InterfaceOf: duplicate method " + m.Name)
+			}
+			ms[j] = ms[j-1]
+		}
+		ms[j] = Method{Name: m.Name, Type: m.Type}
+	}
+
+	// Build a hash and the string representation.
+	hash := fnv1(0, []byte("interface {")...)
+	repr := make([]byte, 0, 64)
+	repr = append(repr, "interface { "...)
+	for i, m := range ms {
+		t := m.Type.common()
+		hash = fnv1(hash, []byte(m.Name)...)
+		hash = fnv1(hash, byte(t.hash>>24), byte(t.hash>>16), byte(t.hash>>8), byte(t.hash))
+		if i > 0 {
+			repr = append(repr, "; "...)
+		}
+		repr = append(repr, m.Name...)
+		repr = append(repr, funcStr((*funcType)(unsafe.Pointer(t)))[len("func"):]...)
+	}
+	hash = fnv1(hash, '}')
+	repr = append(repr, " }"...)
+
+	// Look in cache.
+	if ts, ok := interfaceLookupCache.m.Load(hash); ok {
+		for _, t := range ts.([]*rtype) {
+			if haveIdenticalMethods(t, ms) {
+				return t
+			}
+		}
+	}
+
+	// Not in cache, lock and retry.
+	interfaceLookupCache.Lock()
+	defer interfaceLookupCache.Unlock()
+	if ts, ok := interfaceLookupCache.m.Load(hash); ok {
+		for _, t := range ts.([]*rtype) {
+			if haveIdenticalMethods(t, ms) {
+				return t
+			}
+		}
+	}
+
+	addToCache := func(tt *rtype) Type {
+		var rts []*rtype
+		if rti, ok := interfaceLookupCache.m.Load(hash); ok {
+			rts = rti.([]*rtype)
+		}
+		interfaceLookupCache.m.Store(hash, append(rts, tt))
+		return tt
+	}
+
+	// Look in known types for the same string representation.
+	str := string(repr)
+	for _, tt := range typesByString(str) {
+		if haveIdenticalMethods(tt, ms) {
+			return addToCache(tt)
+		}
+	}
+
+	// Make the interface type.
+	prototype := (*interfaceType)(unsafe.Pointer(TypeOf((*error)(nil)).Elem().common()))
+	it := new(interfaceType)
+	*it = *prototype
+	it.tflag = 0
+	it.hash = hash
+	it.str = resolveReflectName(newName(str, "", false))
+	it.ptrToThis = 0
+	it.pkgPath = name{}
+	it.methods = make([]imethod, len(ms))
+	for i, m := range ms {
+		it.methods[i] = imethod{
+			name: resolveReflectName(newName(m.Name, "", true)),
+			typ:  resolveReflectType(m.Type.common()),
+		}
+	}
+	return addToCache(&it.rtype)
+}
+
+// haveIdenticalMethods reports whether t is an interface type
+// declaring exactly the methods ms, which must be sorted by name.
+func haveIdenticalMethods(t *rtype, ms []Method) bool {
+	if t.Kind() != Interface {
+		return false
+	}
+	tt := (*interfaceType)(unsafe.Pointer(t))
+	if len(tt.methods) != len(ms) {
+		return false
+	}
+	for i := range tt.methods {
+		tm := &tt.methods[i]
+		if t.nameOff(tm.name).name() != ms[i].Name || t.typeOff(tm.typ) != ms[i].Type.common() {
+			return false
+		}
+	}
+	return true
+}
+
 // isReflexive reports whether the == operation on the type is reflexive.
 // That is, x == x for all values x of type t.
 func isReflexive(t *rtype) bool {